@@ -41,8 +41,15 @@ func (f *featureMulticluster) getFeatureName() string {
 func newFeatureMulticluster(cookieAllocator cookie.Allocator, ipProtocols []binding.Protocol) *featureMulticluster {
 	snatCtZones := make(map[binding.Protocol]int)
 	dnatCtZones := make(map[binding.Protocol]int)
-	snatCtZones[ipProtocols[0]] = SNATCtZone
-	dnatCtZones[ipProtocols[0]] = CtZone
+	for _, ipProtocol := range ipProtocols {
+		if ipProtocol == binding.ProtocolIPv6 {
+			snatCtZones[ipProtocol] = SNATCtZoneV6
+			dnatCtZones[ipProtocol] = CtZoneV6
+			continue
+		}
+		snatCtZones[ipProtocol] = SNATCtZone
+		dnatCtZones[ipProtocol] = CtZone
+	}
 	return &featureMulticluster{
 		cookieAllocator: cookieAllocator,
 		cachedFlows:     newFlowCategoryCache(),
@@ -61,45 +68,70 @@ func (f *featureMulticluster) replayFlows() []binding.Flow {
 	return getCachedFlows(f.cachedFlows)
 }
 
+// l3FwdFlowToRemoteViaTun generates the flows to forward cross-cluster Service traffic to a remote cluster's
+// Gateway via a flow based tunnel, for every IP family in f.ipProtocols that peerServiceCIDRs has an entry for; the
+// reply flow of such a family is added only if remoteGatewayIPs also has an entry for it. If encryptedTunnel is
+// true, the flows also load ToMCEncryptedTunnelRegMark, in addition to ToTunnelRegMark.
 func (f *featureMulticluster) l3FwdFlowToRemoteViaTun(
 	localGatewayMAC net.HardwareAddr,
-	peerServiceCIDR net.IPNet,
+	peerServiceCIDRs map[binding.Protocol]net.IPNet,
 	tunnelPeer net.IP,
-	remoteGatewayIP net.IP) []binding.Flow {
-	ipProtocol := getIPProtocol(peerServiceCIDR.IP)
+	remoteGatewayIPs map[binding.Protocol]net.IP,
+	encryptedTunnel bool) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	var flows []binding.Flow
-	flows = append(flows,
-		// This generates the flow to forward cross-cluster request packets based
-		// on Service ClusterIP range.
-		L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
+	for _, ipProtocol := range f.ipProtocols {
+		peerServiceCIDR, ok := peerServiceCIDRs[ipProtocol]
+		if !ok {
+			continue
+		}
+		requestFlow := L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchProtocol(ipProtocol).
 			MatchDstIPNet(peerServiceCIDR).
 			Action().SetSrcMAC(localGatewayMAC).                 // Rewrite src MAC to local gateway MAC.
 			Action().SetDstMAC(GlobalVirtualMACForMulticluster). // Rewrite dst MAC to virtual MC MAC.
-			Action().SetTunnelDst(tunnelPeer).                   // Flow based tunnel. Set tunnel destination.
-			Action().LoadRegMark(ToTunnelRegMark).
-			Action().GotoTable(L3DecTTLTable.GetID()).
-			Done(),
-		// This generates the flow to forward cross-cluster reply traffic based
-		// on Gateway IP.
-		L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
-			Cookie(cookieID).
-			MatchProtocol(ipProtocol).
-			MatchCTStateRpl(true).
-			MatchCTStateTrk(true).
-			MatchDstIP(remoteGatewayIP).
-			Action().SetSrcMAC(localGatewayMAC).
-			Action().SetDstMAC(GlobalVirtualMACForMulticluster).
-			Action().SetTunnelDst(tunnelPeer). // Flow based tunnel. Set tunnel destination.
-			Action().LoadRegMark(ToTunnelRegMark).
-			Action().GotoTable(L3DecTTLTable.GetID()).
-			Done(),
-	)
+			Action().SetTunnelDst(tunnelPeer)                    // Flow based tunnel. Set tunnel destination.
+		if encryptedTunnel {
+			requestFlow = requestFlow.Action().LoadRegMark(ToMCEncryptedTunnelRegMark)
+		}
+		flows = append(flows,
+			// This generates the flow to forward cross-cluster request packets based
+			// on Service ClusterIP range.
+			requestFlow.
+				Action().LoadRegMark(ToTunnelRegMark).
+				Action().GotoTable(L3DecTTLTable.GetID()).
+				Done(),
+		)
+		if remoteGatewayIP, ok := remoteGatewayIPs[ipProtocol]; ok {
+			replyFlow := L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
+				Cookie(cookieID).
+				MatchProtocol(ipProtocol).
+				MatchCTStateRpl(true).
+				MatchCTStateTrk(true).
+				MatchDstIP(remoteGatewayIP).
+				Action().SetSrcMAC(localGatewayMAC).
+				Action().SetDstMAC(GlobalVirtualMACForMulticluster).
+				Action().SetTunnelDst(tunnelPeer) // Flow based tunnel. Set tunnel destination.
+			if encryptedTunnel {
+				replyFlow = replyFlow.Action().LoadRegMark(ToMCEncryptedTunnelRegMark)
+			}
+			flows = append(flows,
+				// This generates the flow to forward cross-cluster reply traffic based
+				// on Gateway IP.
+				replyFlow.
+					Action().LoadRegMark(ToTunnelRegMark).
+					Action().GotoTable(L3DecTTLTable.GetID()).
+					Done(),
+			)
+		}
+	}
 	return flows
 }
 
+// tunnelClassifierFlow generates the flow to classify cross-cluster traffic arriving on tunnelOFPort as such. It is
+// also used for the encrypted (IPsec/WireGuard) tunnel port, since classification doesn't depend on whether the
+// tunnel happens to be encrypted.
 func (f *featureMulticluster) tunnelClassifierFlow(tunnelOFPort uint32) binding.Flow {
 	return ClassifierTable.ofTable.BuildFlow(priorityHigh).
 		Cookie(f.cookieAllocator.Request(f.category).Raw()).
@@ -120,44 +152,64 @@ func (f *featureMulticluster) outputHairpinTunnelFlow(tunnelOFPort uint32) bindi
 		Done()
 }
 
-// snatConntrackFlows generates flows on a multi-cluster Gateway Node to perform SNAT for cross-cluster connections.
-func (f *featureMulticluster) snatConntrackFlows(serviceCIDR net.IPNet, localGatewayIP net.IP) []binding.Flow {
+// PeerSNATPool is a peer cluster's Service CIDRs, tunnel IP, and the IP range its Service traffic is SNAT'd to.
+type PeerSNATPool struct {
+	TunnelPeerIP net.IP
+	ServiceCIDRs map[binding.Protocol]net.IPNet
+	IPRanges     map[binding.Protocol]binding.IPRange
+}
+
+// snatConntrackFlows generates flows on a multi-cluster Gateway Node to SNAT cross-cluster Service connections to
+// a dedicated IP pool per peer cluster, instead of collapsing all peer clusters behind one Gateway IP.
+func (f *featureMulticluster) snatConntrackFlows(peerSNATPools map[string]PeerSNATPool) []binding.Flow {
 	var flows []binding.Flow
-	ipProtocol := getIPProtocol(localGatewayIP)
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
-	flows = append(flows,
-		// This generates the flow to match the first packet of multicluster Service connection, and commit them into
-		// DNAT zone to make sure DNAT is performed before SNAT for any remote cluster traffic.
-		SNATMarkTable.ofTable.BuildFlow(priorityHigh).
-			Cookie(cookieID).
-			MatchProtocol(ipProtocol).
-			MatchDstIPNet(serviceCIDR).
-			MatchCTStateNew(true).
-			MatchCTStateTrk(true).
-			Action().CT(true, SNATMarkTable.GetNext(), f.dnatCtZones[ipProtocol], nil).
-			LoadToCtMark(ConnSNATCTMark).
-			CTDone().
-			Done(),
-		// This generates the flow to perform SNAT for the cross-cluster Service connections.
-		SNATTable.ofTable.BuildFlow(priorityNormal).
-			Cookie(cookieID).
-			MatchProtocol(ipProtocol).
-			MatchCTStateNew(true).
-			MatchCTStateTrk(true).
-			MatchDstIPNet(serviceCIDR).
-			Action().CT(true, SNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
-			SNAT(&binding.IPRange{StartIP: localGatewayIP, EndIP: localGatewayIP}, nil).
-			CTDone().
-			Done(),
-		// This generates the flow to unSNAT reply packets of connections committed in SNAT CT zone by the above flows.
-		UnSNATTable.ofTable.BuildFlow(priorityNormal).
-			Cookie(cookieID).
-			MatchProtocol(ipProtocol).
-			MatchDstIP(localGatewayIP).
-			Action().CT(false, UnSNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
-			NAT().
-			CTDone().
-			Done(),
-	)
+	for _, peerPool := range peerSNATPools {
+		for _, ipProtocol := range f.ipProtocols {
+			serviceCIDR, ok := peerPool.ServiceCIDRs[ipProtocol]
+			if !ok {
+				continue
+			}
+			ipRange, ok := peerPool.IPRanges[ipProtocol]
+			if !ok {
+				continue
+			}
+			flows = append(flows,
+				// This generates the flow to match the first packet of multicluster Service connections, and commit
+				// them into DNAT zone to make sure DNAT is performed before SNAT for any remote cluster traffic.
+				SNATMarkTable.ofTable.BuildFlow(priorityHigh).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchDstIPNet(serviceCIDR).
+					MatchCTStateNew(true).
+					MatchCTStateTrk(true).
+					Action().CT(true, SNATMarkTable.GetNext(), f.dnatCtZones[ipProtocol], nil).
+					LoadToCtMark(ConnSNATCTMark).
+					CTDone().
+					Done(),
+				// This generates the flow to SNAT this peer's cross-cluster Service connections to its own IP pool.
+				SNATTable.ofTable.BuildFlow(priorityNormal).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(true).
+					MatchCTStateTrk(true).
+					MatchDstIPNet(serviceCIDR).
+					Action().CT(true, SNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
+					SNAT(&ipRange, nil).
+					CTDone().
+					Done(),
+				// This generates the flow to unSNAT reply packets for this peer's SNAT'd connections.
+				UnSNATTable.ofTable.BuildFlow(priorityNormal).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchDstIPRange(ipRange).
+					MatchTunnelSrc(peerPool.TunnelPeerIP).
+					Action().CT(false, UnSNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
+					NAT().
+					CTDone().
+					Done(),
+			)
+		}
+	}
 	return flows
 }